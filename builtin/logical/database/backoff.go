@@ -0,0 +1,40 @@
+package database
+
+import "time"
+
+// rotationBackoffConfig controls how the rotation queue's retry priority
+// escalates after consecutive failures, and when a static role is pulled
+// out of the queue and dead-lettered.
+type rotationBackoffConfig struct {
+	BaseBackoff time.Duration `json:"base_backoff"`
+	MaxBackoff  time.Duration `json:"max_backoff"`
+	MaxAttempts int           `json:"max_attempts"`
+}
+
+var defaultRotationBackoffConfig = rotationBackoffConfig{
+	BaseBackoff: 10 * time.Second,
+	MaxBackoff:  1 * time.Hour,
+	MaxAttempts: 5,
+}
+
+// nextBackoff returns the delay to wait before the next rotation attempt,
+// doubling for each consecutive failure up to MaxBackoff. attempts is
+// 1-indexed: the delay after the first failure is BaseBackoff.
+func (c rotationBackoffConfig) nextBackoff(attempts int) time.Duration {
+	backoff := c.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= c.MaxBackoff {
+			return c.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// rotationQueueItem is the Value stored on a static account rotation
+// queue.Item, carrying the in-flight WALID (if any) and the number of
+// consecutive rotation failures seen so far.
+type rotationQueueItem struct {
+	WALID    string
+	Attempts int
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRotationBackoffConfig_NextBackoff(t *testing.T) {
+	cfg := rotationBackoffConfig{
+		BaseBackoff: 10 * time.Second,
+		MaxBackoff:  1 * time.Minute,
+		MaxAttempts: 5,
+	}
+
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 3, want: 40 * time.Second},
+		{attempts: 4, want: 1 * time.Minute}, // would be 80s, capped
+		{attempts: 5, want: 1 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		got := cfg.nextBackoff(tc.attempts)
+		if got != tc.want {
+			t.Errorf("nextBackoff(%d) = %s, want %s", tc.attempts, got, tc.want)
+		}
+	}
+}
+
+func TestRotationBackoffConfig_NextBackoff_NeverExceedsMax(t *testing.T) {
+	cfg := defaultRotationBackoffConfig
+
+	for attempts := 1; attempts <= cfg.MaxAttempts+5; attempts++ {
+		if got := cfg.nextBackoff(attempts); got > cfg.MaxBackoff {
+			t.Fatalf("nextBackoff(%d) = %s, exceeds MaxBackoff %s", attempts, got, cfg.MaxBackoff)
+		}
+	}
+}
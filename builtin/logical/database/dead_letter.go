@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/logical"
+)
+
+const deadLetterStoragePrefix = "dead-letter/"
+
+// deadLetterEntry records a static role rotation that exceeded
+// rotationBackoffConfig.MaxAttempts consecutive failures and was pulled out
+// of the rotation queue for operator attention.
+type deadLetterEntry struct {
+	Role           string    `json:"role"`
+	Attempts       int       `json:"attempts"`
+	LastError      string    `json:"last_error"`
+	DeadLetterTime time.Time `json:"dead_letter_time"`
+	WALID          string    `json:"wal_id,omitempty"`
+}
+
+func (b *databaseBackend) putDeadLetter(ctx context.Context, s logical.Storage, entry deadLetterEntry) error {
+	se, err := logical.StorageEntryJSON(deadLetterStoragePrefix+entry.Role, entry)
+	if err != nil {
+		return err
+	}
+	return s.Put(ctx, se)
+}
+
+func (b *databaseBackend) getDeadLetter(ctx context.Context, s logical.Storage, role string) (*deadLetterEntry, error) {
+	se, err := s.Get(ctx, deadLetterStoragePrefix+role)
+	if err != nil {
+		return nil, err
+	}
+	if se == nil {
+		return nil, nil
+	}
+
+	var entry deadLetterEntry
+	if err := se.DecodeJSON(&entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (b *databaseBackend) listDeadLetters(ctx context.Context, s logical.Storage) ([]deadLetterEntry, error) {
+	keys, err := s.List(ctx, deadLetterStoragePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]deadLetterEntry, 0, len(keys))
+	for _, k := range keys {
+		entry, err := b.getDeadLetter(ctx, s, strings.TrimSuffix(k, "/"))
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries, nil
+}
+
+func (b *databaseBackend) deleteDeadLetter(ctx context.Context, s logical.Storage, role string) error {
+	return s.Delete(ctx, deadLetterStoragePrefix+role)
+}
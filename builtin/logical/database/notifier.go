@@ -0,0 +1,25 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// RotationEvent describes a single rotation attempt, successful or not, for
+// delivery to a RotationNotifier.
+type RotationEvent struct {
+	Role         string    `json:"role"`
+	Username     string    `json:"username"`
+	DBName       string    `json:"db_name"`
+	RotationTime time.Time `json:"rotation_time"`
+	NextRotation time.Time `json:"next_rotation,omitempty"`
+	Success      bool      `json:"success"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// RotationNotifier is notified whenever a static account rotation succeeds,
+// and whenever a rotation from the periodic rotation queue fails, so downstream systems (config reloaders, secret
+// consumers) can react to credential changes instead of polling Vault.
+type RotationNotifier interface {
+	Notify(ctx context.Context, event RotationEvent) error
+}
@@ -0,0 +1,61 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+)
+
+// PasswordPolicy describes the constraints an operator-supplied password
+// must satisfy before Vault will use it to rotate a static account.
+type PasswordPolicy struct {
+	MinLength      int  `json:"min_length" mapstructure:"min_length"`
+	RequireUpper   bool `json:"require_upper" mapstructure:"require_upper"`
+	RequireLower   bool `json:"require_lower" mapstructure:"require_lower"`
+	RequireNumber  bool `json:"require_number" mapstructure:"require_number"`
+	RequireSpecial bool `json:"require_special" mapstructure:"require_special"`
+}
+
+// defaultPasswordPolicy is applied to static roles that don't configure
+// their own password_policy.
+var defaultPasswordPolicy = PasswordPolicy{MinLength: 8}
+
+// Validate returns an error describing the first requirement that password
+// fails to meet, or nil if it satisfies the policy.
+func (p PasswordPolicy) Validate(password string) error {
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = defaultPasswordPolicy.MinLength
+	}
+
+	if len(password) < minLength {
+		return fmt.Errorf("password must be at least %d characters", minLength)
+	}
+
+	var hasUpper, hasLower, hasNumber, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsNumber(r):
+			hasNumber = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r):
+			hasSpecial = true
+		}
+	}
+
+	switch {
+	case p.RequireUpper && !hasUpper:
+		return errors.New("password must contain an uppercase character")
+	case p.RequireLower && !hasLower:
+		return errors.New("password must contain a lowercase character")
+	case p.RequireNumber && !hasNumber:
+		return errors.New("password must contain a numeric character")
+	case p.RequireSpecial && !hasSpecial:
+		return errors.New("password must contain a special character")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package database
+
+import "testing"
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		policy  PasswordPolicy
+		pass    string
+		wantErr bool
+	}{
+		{
+			name:   "defaulted MinLength rejects a short password before other requirements",
+			policy: PasswordPolicy{RequireUpper: true},
+			pass:   "short",
+			// "short" is 5 chars, under the defaulted MinLength of 8, so the
+			// length check should fire before the upper-case check.
+			wantErr: true,
+		},
+		{
+			name:    "meets defaulted MinLength and has no other requirements",
+			policy:  PasswordPolicy{},
+			pass:    "longenough",
+			wantErr: false,
+		},
+		{
+			name:    "too short against an explicit MinLength",
+			policy:  PasswordPolicy{MinLength: 12},
+			pass:    "tooshort1!",
+			wantErr: true,
+		},
+		{
+			name:    "missing required uppercase",
+			policy:  PasswordPolicy{RequireUpper: true},
+			pass:    "alllowercase1!",
+			wantErr: true,
+		},
+		{
+			name:    "missing required lowercase",
+			policy:  PasswordPolicy{RequireLower: true},
+			pass:    "ALLUPPERCASE1!",
+			wantErr: true,
+		},
+		{
+			name:    "missing required number",
+			policy:  PasswordPolicy{RequireNumber: true},
+			pass:    "NoNumbersHere!",
+			wantErr: true,
+		},
+		{
+			name:    "missing required special character",
+			policy:  PasswordPolicy{RequireSpecial: true},
+			pass:    "NoSpecialChars1",
+			wantErr: true,
+		},
+		{
+			name: "satisfies every requirement",
+			policy: PasswordPolicy{
+				MinLength:      10,
+				RequireUpper:   true,
+				RequireLower:   true,
+				RequireNumber:  true,
+				RequireSpecial: true,
+			},
+			pass:    "Abcdefg1!2",
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate(tc.pass)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
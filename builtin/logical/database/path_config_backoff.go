@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+)
+
+const backoffConfigStorageKey = "config/backoff"
+
+// getRotationBackoffConfig returns the mount's configured backoff settings,
+// falling back to defaultRotationBackoffConfig if unset.
+func (b *databaseBackend) getRotationBackoffConfig(ctx context.Context, s logical.Storage) (rotationBackoffConfig, error) {
+	entry, err := s.Get(ctx, backoffConfigStorageKey)
+	if err != nil {
+		return rotationBackoffConfig{}, err
+	}
+	if entry == nil {
+		return defaultRotationBackoffConfig, nil
+	}
+
+	var config rotationBackoffConfig
+	if err := entry.DecodeJSON(&config); err != nil {
+		return rotationBackoffConfig{}, err
+	}
+	return config, nil
+}
+
+func pathConfigBackoff(b *databaseBackend) *framework.Path {
+	return &framework.Path{
+		Pattern: "config/backoff",
+		Fields: map[string]*framework.FieldSchema{
+			"base_backoff": {
+				Type:        framework.TypeDurationSecond,
+				Description: "Delay before the first retry after a rotation failure.",
+				Default:     int64(defaultRotationBackoffConfig.BaseBackoff.Seconds()),
+			},
+			"max_backoff": {
+				Type:        framework.TypeDurationSecond,
+				Description: "Maximum delay between rotation retries.",
+				Default:     int64(defaultRotationBackoffConfig.MaxBackoff.Seconds()),
+			},
+			"max_attempts": {
+				Type:        framework.TypeInt,
+				Description: "Number of consecutive failures before a role is dead-lettered.",
+				Default:     defaultRotationBackoffConfig.MaxAttempts,
+			},
+		},
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.ReadOperation:   b.pathConfigBackoffRead,
+			logical.UpdateOperation: b.pathConfigBackoffWrite,
+		},
+
+		HelpSynopsis:    pathConfigBackoffHelpSyn,
+		HelpDescription: pathConfigBackoffHelpDesc,
+	}
+}
+
+func (b *databaseBackend) pathConfigBackoffRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	config, err := b.getRotationBackoffConfig(ctx, req.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"base_backoff": config.BaseBackoff.String(),
+			"max_backoff":  config.MaxBackoff.String(),
+			"max_attempts": config.MaxAttempts,
+		},
+	}, nil
+}
+
+func (b *databaseBackend) pathConfigBackoffWrite(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	config := rotationBackoffConfig{
+		BaseBackoff: time.Duration(data.Get("base_backoff").(int)) * time.Second,
+		MaxBackoff:  time.Duration(data.Get("max_backoff").(int)) * time.Second,
+		MaxAttempts: data.Get("max_attempts").(int),
+	}
+
+	entry, err := logical.StorageEntryJSON(backoffConfigStorageKey, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Storage.Put(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+const pathConfigBackoffHelpSyn = `Configure rotation retry backoff and dead-letter thresholds.`
+const pathConfigBackoffHelpDesc = `
+This path configures how the static role rotation queue backs off after
+consecutive failures, and after how many attempts a role is pulled out of
+the queue and surfaced at this mount's static-roles/failed path.
+`
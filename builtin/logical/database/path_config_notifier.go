@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+)
+
+const notifierConfigStorageKey = "config/notifier"
+
+// notifierConfig is the mount-wide configuration for rotation event
+// notifications.
+type notifierConfig struct {
+	WebhookURL string `json:"webhook_url"`
+}
+
+// notifier builds the RotationNotifier for this mount's current
+// configuration, or returns nil if no notifier is configured.
+func (b *databaseBackend) notifier(ctx context.Context, s logical.Storage) (RotationNotifier, error) {
+	entry, err := s.Get(ctx, notifierConfigStorageKey)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var config notifierConfig
+	if err := entry.DecodeJSON(&config); err != nil {
+		return nil, err
+	}
+	if config.WebhookURL == "" {
+		return nil, nil
+	}
+
+	return NewWebhookNotifier(config.WebhookURL), nil
+}
+
+func pathConfigNotifier(b *databaseBackend) *framework.Path {
+	return &framework.Path{
+		Pattern: "config/notifier",
+		Fields: map[string]*framework.FieldSchema{
+			"webhook_url": {
+				Type:        framework.TypeString,
+				Description: "URL Vault POSTs a JSON rotation event to after each successful static role rotation and each failed rotation from the rotation queue.",
+			},
+		},
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.ReadOperation:   b.pathConfigNotifierRead,
+			logical.UpdateOperation: b.pathConfigNotifierWrite,
+		},
+
+		HelpSynopsis:    pathConfigNotifierHelpSyn,
+		HelpDescription: pathConfigNotifierHelpDesc,
+	}
+}
+
+func (b *databaseBackend) pathConfigNotifierRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	entry, err := req.Storage.Get(ctx, notifierConfigStorageKey)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	var config notifierConfig
+	if err := entry.DecodeJSON(&config); err != nil {
+		return nil, err
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"webhook_url": config.WebhookURL,
+		},
+	}, nil
+}
+
+func (b *databaseBackend) pathConfigNotifierWrite(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	config := notifierConfig{
+		WebhookURL: data.Get("webhook_url").(string),
+	}
+
+	entry, err := logical.StorageEntryJSON(notifierConfigStorageKey, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Storage.Put(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+const pathConfigNotifierHelpSyn = `Configure a webhook to notify on static role rotation events.`
+const pathConfigNotifierHelpDesc = `
+This path configures a webhook endpoint that Vault POSTs a JSON event to
+after each successful rotation of a static role's credentials, and after
+each failed rotation attempt from the periodic rotation queue. A failed
+rotation requested through static-roles/:name/rotate sends no event. This
+lets downstream systems react to rotations instead of polling.
+`
@@ -0,0 +1,52 @@
+package database
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+)
+
+func pathStaticRolesFailed(b *databaseBackend) *framework.Path {
+	return &framework.Path{
+		Pattern: "static-roles/failed",
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.ReadOperation: b.pathStaticRolesFailedRead,
+		},
+
+		HelpSynopsis:    pathStaticRolesFailedHelpSyn,
+		HelpDescription: pathStaticRolesFailedHelpDesc,
+	}
+}
+
+func (b *databaseBackend) pathStaticRolesFailedRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	entries, err := b.listDeadLetters(ctx, req.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]string, 0, len(entries))
+	failures := make(map[string]interface{}, len(entries))
+	for _, entry := range entries {
+		roles = append(roles, entry.Role)
+		failures[entry.Role] = map[string]interface{}{
+			"attempts":         entry.Attempts,
+			"last_error":       entry.LastError,
+			"dead_letter_time": entry.DeadLetterTime,
+		}
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"keys":     roles,
+			"failures": failures,
+		},
+	}, nil
+}
+
+const pathStaticRolesFailedHelpSyn = `List static roles whose rotation has been dead-lettered after repeated failures.`
+const pathStaticRolesFailedHelpDesc = `
+This path lists static roles that exceeded the configured maximum rotation
+attempts and were pulled out of the rotation queue. Use
+static-roles/failed/:name to inspect a single entry, retry it, or purge it.
+`
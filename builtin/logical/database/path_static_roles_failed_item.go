@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/vault/helper/queue"
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+)
+
+func pathStaticRolesFailedItem(b *databaseBackend) *framework.Path {
+	return &framework.Path{
+		Pattern: "static-roles/failed/" + framework.GenericNameRegex("name"),
+		Fields: map[string]*framework.FieldSchema{
+			"name": {
+				Type:        framework.TypeString,
+				Description: "Name of the dead-lettered static role.",
+			},
+		},
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.ReadOperation:   b.pathStaticRolesFailedItemRead,
+			logical.UpdateOperation: b.pathStaticRolesFailedItemRetry,
+			logical.DeleteOperation: b.pathStaticRolesFailedItemPurge,
+		},
+
+		HelpSynopsis:    pathStaticRolesFailedItemHelpSyn,
+		HelpDescription: pathStaticRolesFailedItemHelpDesc,
+	}
+}
+
+func (b *databaseBackend) pathStaticRolesFailedItemRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	entry, err := b.getDeadLetter(ctx, req.Storage, data.Get("name").(string))
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, nil
+	}
+
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"role":             entry.Role,
+			"attempts":         entry.Attempts,
+			"last_error":       entry.LastError,
+			"dead_letter_time": entry.DeadLetterTime,
+		},
+	}, nil
+}
+
+// pathStaticRolesFailedItemRetry re-queues a dead-lettered role for
+// immediate rotation and clears its failure history.
+func (b *databaseBackend) pathStaticRolesFailedItemRetry(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	name := data.Get("name").(string)
+
+	entry, err := b.getDeadLetter(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return logical.ErrorResponse("no dead-lettered role named %q", name), nil
+	}
+
+	if err := b.credRotationQueue.PushItem(&queue.Item{
+		Key:      name,
+		Priority: time.Now().Unix(),
+		Value:    &rotationQueueItem{WALID: entry.WALID, Attempts: 0},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := b.deleteDeadLetter(ctx, req.Storage, name); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// pathStaticRolesFailedItemPurge discards a dead-lettered role's failure
+// history without re-queueing it for rotation. It also deletes the role's
+// in-flight WAL entry, if any, since that WAL holds the plaintext old and
+// new passwords for the account and nothing else will ever revisit it once
+// the dead-letter record is gone.
+func (b *databaseBackend) pathStaticRolesFailedItemPurge(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	name := data.Get("name").(string)
+
+	entry, err := b.getDeadLetter(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil && entry.WALID != "" {
+		if err := framework.DeleteWAL(ctx, req.Storage, entry.WALID); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, b.deleteDeadLetter(ctx, req.Storage, name)
+}
+
+const pathStaticRolesFailedItemHelpSyn = `Inspect, retry, or purge a single dead-lettered static role.`
+const pathStaticRolesFailedItemHelpDesc = `
+GET returns the role's failure history. POST re-queues it for immediate
+rotation and clears the history. DELETE purges it without retrying.
+`
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+)
+
+func pathStaticRoleRotate(b *databaseBackend) *framework.Path {
+	return &framework.Path{
+		Pattern: "static-roles/" + framework.GenericNameRegex("name") + "/rotate",
+		Fields: map[string]*framework.FieldSchema{
+			"name": {
+				Type:        framework.TypeString,
+				Description: "Name of the static role.",
+			},
+			"password": {
+				Type:        framework.TypeString,
+				Description: "Password to rotate the static account to. Requires the role to have allow_manual_password set. If unset, Vault generates a password as usual.",
+			},
+		},
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.UpdateOperation: b.pathStaticRoleRotateWrite,
+		},
+
+		HelpSynopsis:    pathStaticRoleRotateHelpSyn,
+		HelpDescription: pathStaticRoleRotateHelpDesc,
+	}
+}
+
+func (b *databaseBackend) pathStaticRoleRotateWrite(ctx context.Context, req *logical.Request, data *framework.FieldData) (*logical.Response, error) {
+	name := data.Get("name").(string)
+	if name == "" {
+		return logical.ErrorResponse("missing role name"), nil
+	}
+
+	role, err := b.staticAccountRepository(req.Storage).Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrNotStaticRole) {
+			return logical.ErrorResponse("role %q is not a static role", name), nil
+		}
+		return nil, err
+	}
+	if role == nil {
+		return logical.ErrorResponse("unknown role: %s", name), nil
+	}
+
+	password := data.Get("password").(string)
+	if password != "" && !role.StaticAccount.AllowManualPassword {
+		return logical.ErrorResponse("manual password rotation is not enabled for role %q", name), nil
+	}
+
+	input := &setPasswordInput{
+		RoleName: name,
+		Role:     role,
+		Password: password,
+		Manual:   password != "",
+	}
+
+	if _, err := b.createUpdateStaticAccount(ctx, req.Storage, input); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+const pathStaticRoleRotateHelpSyn = `Request an immediate rotation of a static role's credentials.`
+const pathStaticRoleRotateHelpDesc = `
+This path attempts to rotate a static role's credentials immediately. By
+default Vault generates a new password; if the role has allow_manual_password
+set, an operator may instead supply the exact password to rotate to, which
+must satisfy the role's configured password_policy.
+`
@@ -0,0 +1,20 @@
+package database
+
+import (
+	"time"
+
+	"github.com/hashicorp/vault/builtin/logical/database/dbplugin"
+)
+
+// roleEntry is the storage structure for a Vault role that maps to a
+// database user, either dynamically generated on each lease or, if
+// StaticAccount is non-nil, rotated in place on a schedule.
+type roleEntry struct {
+	Name           string              `json:"name"`
+	DBName         string              `json:"db_name"`
+	Statements     dbplugin.Statements `json:"statements"`
+	DefaultTTL     time.Duration       `json:"default_ttl"`
+	MaxTTL         time.Duration       `json:"max_ttl"`
+	CredentialType string              `json:"credential_type"`
+	StaticAccount  *StaticAccount      `json:"static_account" mapstructure:"static_account"`
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	metrics "github.com/armon/go-metrics"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/vault/builtin/logical/database/dbplugin"
@@ -23,6 +24,8 @@ import (
 // - The queue of passwords needing rotation is completely empty.
 // - It encounters the first password not yet needing rotation.
 func (b *databaseBackend) rotateCredentials(ctx context.Context, s logical.Storage) error {
+	repo := b.staticAccountRepository(s)
+
 	for {
 		item, err := b.credRotationQueue.PopItem()
 		if err != nil {
@@ -32,7 +35,7 @@ func (b *databaseBackend) rotateCredentials(ctx context.Context, s logical.Stora
 			return err
 		}
 
-		role, err := b.Role(ctx, s, item.Key)
+		role, err := repo.Get(ctx, item.Key)
 		if err != nil {
 			b.logger.Warn(fmt.Sprintf("unable load role (%s)", item.Key), "error", err)
 			continue
@@ -49,47 +52,80 @@ func (b *databaseBackend) rotateCredentials(ctx context.Context, s logical.Stora
 				Role:     role,
 			}
 
-			// check for existing WAL entry with a Password
-			if walID, ok := item.Value.(string); ok {
-				walEntry := b.walForItemValue(ctx, s, walID)
+			// check for existing WAL entry with a Password, and how many
+			// consecutive failures this item has already accrued
+			prev, _ := item.Value.(*rotationQueueItem)
+			if prev != nil && prev.WALID != "" {
+				walEntry := b.walForItemValue(ctx, s, prev.WALID)
 				if walEntry != nil && walEntry.NewPassword != "" {
 					input.Password = walEntry.NewPassword
-					input.WALID = walID
+					input.WALID = prev.WALID
 				}
 			}
 
+			metrics.IncrCounter([]string{"database", "static_role", "rotate", "attempt"}, 1)
+
 			// lvr is the roles' last vault rotation
 			resp, err := b.createUpdateStaticAccount(ctx, s, input)
 			if err != nil {
 				b.logger.Warn("unable rotate credentials in periodic function", "error", err)
-				// add the item to the re-queue slice
-				newItem := queue.Item{
-					Key:      item.Key,
-					Priority: item.Priority + 10,
+				metrics.IncrCounter([]string{"database", "static_role", "rotate", "failure"}, 1)
+
+				attempts := 1
+				if prev != nil {
+					attempts = prev.Attempts + 1
+				}
+				walID := resp.WALID
+				if walID == "" && prev != nil {
+					walID = prev.WALID
 				}
 
-				// preserve the WALID if it was returned
-				if resp.WALID != "" {
-					newItem.Value = resp.WALID
+				backoffConfig, cerr := b.getRotationBackoffConfig(ctx, s)
+				if cerr != nil {
+					b.logger.Warn("unable to load rotation backoff config", "error", cerr)
+					backoffConfig = defaultRotationBackoffConfig
 				}
 
-				if err := b.credRotationQueue.PushItem(&newItem); err != nil {
-					b.logger.Warn("unable to push item on to queue", "error", err)
+				if attempts >= backoffConfig.MaxAttempts {
+					metrics.IncrCounter([]string{"database", "static_role", "rotate", "dead_letter"}, 1)
+					if derr := b.putDeadLetter(ctx, s, deadLetterEntry{
+						Role:           item.Key,
+						Attempts:       attempts,
+						LastError:      err.Error(),
+						DeadLetterTime: time.Now(),
+						WALID:          walID,
+					}); derr != nil {
+						b.logger.Warn("unable to record dead-lettered role", "role", item.Key, "error", derr)
+					}
+				} else {
+					newItem := queue.Item{
+						Key:      item.Key,
+						Priority: time.Now().Add(backoffConfig.nextBackoff(attempts)).Unix(),
+						Value:    &rotationQueueItem{WALID: walID, Attempts: attempts},
+					}
+					if err := b.credRotationQueue.PushItem(&newItem); err != nil {
+						b.logger.Warn("unable to push item on to queue", "error", err)
+					}
 				}
+
+				b.notifyRotation(ctx, s, RotationEvent{
+					Role:         item.Key,
+					Username:     role.StaticAccount.Username,
+					DBName:       role.DBName,
+					RotationTime: time.Now(),
+					Success:      false,
+					Error:        err.Error(),
+				})
+
 				// go to next item
 				continue
 			}
 
-			// guard against RotationTime not being set or zero-value
-			lvr := resp.RotationTime
-			if lvr.IsZero() {
-				lvr = time.Now()
-			}
+			metrics.IncrCounter([]string{"database", "static_role", "rotate", "success"}, 1)
 
-			nextRotation := lvr.Add(role.StaticAccount.RotationPeriod)
 			newItem := queue.Item{
 				Key:      item.Key,
-				Priority: nextRotation.Unix(),
+				Priority: resp.NextRotation.Unix(),
 			}
 			if err := b.credRotationQueue.PushItem(&newItem); err != nil {
 				b.logger.Warn("unable to push item on to queue", "error", err)
@@ -132,10 +168,19 @@ type setPasswordInput struct {
 	Password   string
 	CreateUser bool
 	WALID      string
+
+	// Manual indicates Password was supplied by an operator through the
+	// rotate API, rather than reused from an in-flight WAL entry. Only in
+	// this case is the password validated against the role's password
+	// policy and recorded as operator-supplied in the WAL.
+	Manual bool
 }
 
 type setPasswordResponse struct {
 	RotationTime time.Time
+	// NextRotation is when this account is next due for rotation, per its
+	// RotationSchedule.
+	NextRotation time.Time
 	// Optional return field, in the event WAL was created and not destroyed
 	// during the operation
 	WALID string
@@ -174,6 +219,13 @@ func (b *databaseBackend) createUpdateStaticAccount(ctx context.Context, s logic
 		if err != nil {
 			return setResponse, err
 		}
+	} else if input.Manual {
+		if !input.Role.StaticAccount.AllowManualPassword {
+			return setResponse, fmt.Errorf("manual password rotation is not enabled for role %q", input.RoleName)
+		}
+		if err := input.Role.StaticAccount.PasswordPolicy.Validate(newPassword); err != nil {
+			return setResponse, fmt.Errorf("password does not meet policy: %w", err)
+		}
 	}
 
 	db.RLock()
@@ -198,6 +250,7 @@ func (b *databaseBackend) createUpdateStaticAccount(ctx context.Context, s logic
 			OldPassword:       input.Role.StaticAccount.Password,
 			Statements:        stmts,
 			LastVaultRotation: input.Role.StaticAccount.LastVaultRotation,
+			OperatorSupplied:  input.Manual,
 		})
 		if err != nil {
 			// TODO: error wrap here?
@@ -223,11 +276,8 @@ func (b *databaseBackend) createUpdateStaticAccount(ctx context.Context, s logic
 	input.Role.StaticAccount.Password = password
 	setResponse.RotationTime = lvr
 
-	entry, err := logical.StorageEntryJSON("role/"+input.RoleName, input.Role)
-	if err != nil {
-		return setResponse, err
-	}
-	if err := s.Put(ctx, entry); err != nil {
+	input.Role.Name = input.RoleName
+	if err := b.staticAccountRepository(s).Put(ctx, input.Role); err != nil {
 		return setResponse, err
 	}
 
@@ -236,5 +286,66 @@ func (b *databaseBackend) createUpdateStaticAccount(ctx context.Context, s logic
 		merr = multierror.Append(merr, err)
 	}
 
+	schedule, err := input.Role.StaticAccount.Schedule()
+	if err != nil {
+		schedule = periodicSchedule{Period: input.Role.StaticAccount.RotationPeriod}
+	}
+	setResponse.NextRotation = schedule.NextRotation(lvr)
+
+	b.notifyRotation(ctx, s, RotationEvent{
+		Role:         input.RoleName,
+		Username:     input.Role.StaticAccount.Username,
+		DBName:       input.Role.DBName,
+		RotationTime: lvr,
+		NextRotation: setResponse.NextRotation,
+		Success:      true,
+	})
+
 	return setResponse, merr
 }
+
+// notifyInFlightLimit bounds how many rotation notifications may be
+// dispatched concurrently, so a backlog of slow or unreachable webhook
+// endpoints can't spawn unbounded goroutines.
+const notifyInFlightLimit = 16
+
+// notifyTimeout is the outer deadline for delivering a single notification,
+// a safety net above WebhookNotifier's own per-attempt timeouts and
+// backoff.
+const notifyTimeout = 1 * time.Minute
+
+var notifyInFlight = make(chan struct{}, notifyInFlightLimit)
+
+// notifyRotation dispatches event to the mount's configured RotationNotifier,
+// if any, on a background goroutine. rotateCredentials runs on a periodic
+// ticker and processes every due item in one pass, so notification delivery
+// must never block the rotation loop itself; failures are logged rather
+// than returned.
+func (b *databaseBackend) notifyRotation(ctx context.Context, s logical.Storage, event RotationEvent) {
+	notifier, err := b.notifier(ctx, s)
+	if err != nil {
+		b.logger.Warn("unable to load notifier config", "error", err)
+		return
+	}
+	if notifier == nil {
+		return
+	}
+
+	select {
+	case notifyInFlight <- struct{}{}:
+	default:
+		b.logger.Warn("dropping rotation notification, too many in flight", "role", event.Role)
+		return
+	}
+
+	go func() {
+		defer func() { <-notifyInFlight }()
+
+		notifyCtx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		if err := notifier.Notify(notifyCtx, event); err != nil {
+			b.logger.Warn("failed to send rotation notification", "role", event.Role, "error", err)
+		}
+	}()
+}
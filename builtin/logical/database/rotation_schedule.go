@@ -0,0 +1,116 @@
+package database
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// RotationSchedule computes when a static account is next due for
+// rotation. It replaces a bare RotationPeriod as the source of truth for
+// re-enqueueing work in rotateCredentials, letting roles opt into cron
+// expressions or jittered intervals instead of a fixed period.
+type RotationSchedule interface {
+	// NextRotation returns the next time rotation should occur, given the
+	// last time it ran.
+	NextRotation(lastRotation time.Time) time.Time
+
+	// String renders the schedule back into the form ParseRotationSchedule
+	// accepts, so it can round-trip through storage.
+	String() string
+}
+
+// periodicSchedule rotates every Period. It's the default strategy, and
+// the only one expressible via the legacy rotation_period field.
+type periodicSchedule struct {
+	Period time.Duration
+}
+
+func (p periodicSchedule) NextRotation(lastRotation time.Time) time.Time {
+	return lastRotation.Add(p.Period)
+}
+
+func (p periodicSchedule) String() string {
+	return p.Period.String()
+}
+
+// jitteredSchedule rotates every Period, plus or minus a random fraction
+// of that period, so a large fleet of static accounts sharing the same
+// period doesn't all rotate in the same instant.
+type jitteredSchedule struct {
+	Period time.Duration
+	Jitter float64
+}
+
+func (j jitteredSchedule) NextRotation(lastRotation time.Time) time.Time {
+	spread := float64(j.Period) * j.Jitter
+	offset := time.Duration(spread * (rand.Float64()*2 - 1))
+	return lastRotation.Add(j.Period + offset)
+}
+
+func (j jitteredSchedule) String() string {
+	return fmt.Sprintf("%s±%d%%", j.Period, int(math.Round(j.Jitter*100)))
+}
+
+// cronSchedule rotates according to a standard five-field cron expression,
+// e.g. "0 2 * * 1-5" for every weekday at 02:00.
+type cronSchedule struct {
+	expression string
+	schedule   cron.Schedule
+}
+
+func (c *cronSchedule) NextRotation(lastRotation time.Time) time.Time {
+	return c.schedule.Next(lastRotation)
+}
+
+func (c *cronSchedule) String() string {
+	return c.expression
+}
+
+var jitteredScheduleRe = regexp.MustCompile(`^(.+?)\x{00b1}(\d+)%$`)
+
+// ParseRotationSchedule parses the rotation_schedule field of a static
+// role. Three forms are accepted:
+//   - a bare duration, e.g. "24h"             -> periodicSchedule
+//   - a duration with jitter, e.g. "24h±10%"  -> jitteredSchedule
+//   - a five-field cron expression, e.g. "0 2 * * *" -> cronSchedule
+//
+// An empty raw string returns a nil schedule; callers should fall back to
+// RotationPeriod in that case.
+func ParseRotationSchedule(raw string) (RotationSchedule, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	if m := jitteredScheduleRe.FindStringSubmatch(raw); m != nil {
+		period, err := time.ParseDuration(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rotation_schedule %q: %w", raw, err)
+		}
+		pct, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rotation_schedule %q: %w", raw, err)
+		}
+		return jitteredSchedule{Period: period, Jitter: float64(pct) / 100}, nil
+	}
+
+	if period, err := time.ParseDuration(raw); err == nil {
+		return periodicSchedule{Period: period}, nil
+	}
+
+	if strings.Count(raw, " ") >= 4 {
+		schedule, err := cron.ParseStandard(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rotation_schedule %q: %w", raw, err)
+		}
+		return &cronSchedule{expression: raw, schedule: schedule}, nil
+	}
+
+	return nil, fmt.Errorf("invalid rotation_schedule %q: not a duration, jittered duration, or cron expression", raw)
+}
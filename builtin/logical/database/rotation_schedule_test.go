@@ -0,0 +1,89 @@
+package database
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRotationSchedule_Empty(t *testing.T) {
+	schedule, err := ParseRotationSchedule("")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if schedule != nil {
+		t.Fatalf("expected nil schedule for empty input, got %v", schedule)
+	}
+}
+
+func TestParseRotationSchedule_BareDuration(t *testing.T) {
+	schedule, err := ParseRotationSchedule("24h")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ps, ok := schedule.(periodicSchedule)
+	if !ok {
+		t.Fatalf("expected periodicSchedule, got %T", schedule)
+	}
+	if ps.Period != 24*time.Hour {
+		t.Fatalf("expected period of 24h, got %s", ps.Period)
+	}
+}
+
+func TestParseRotationSchedule_JitteredDuration(t *testing.T) {
+	schedule, err := ParseRotationSchedule("24h±10%")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	js, ok := schedule.(jitteredSchedule)
+	if !ok {
+		t.Fatalf("expected jitteredSchedule, got %T", schedule)
+	}
+	if js.Period != 24*time.Hour {
+		t.Fatalf("expected period of 24h, got %s", js.Period)
+	}
+	if js.Jitter != 0.1 {
+		t.Fatalf("expected jitter of 0.1, got %f", js.Jitter)
+	}
+}
+
+func TestParseRotationSchedule_Cron(t *testing.T) {
+	schedule, err := ParseRotationSchedule("0 2 * * 1-5")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := schedule.(*cronSchedule); !ok {
+		t.Fatalf("expected *cronSchedule, got %T", schedule)
+	}
+}
+
+func TestParseRotationSchedule_Invalid(t *testing.T) {
+	if _, err := ParseRotationSchedule("not-a-schedule"); err == nil {
+		t.Fatal("expected an error for an unparseable schedule")
+	}
+}
+
+func TestPeriodicSchedule_NextRotation(t *testing.T) {
+	p := periodicSchedule{Period: time.Hour}
+	last := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got := p.NextRotation(last)
+	want := last.Add(time.Hour)
+	if !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestJitteredSchedule_NextRotation_WithinBounds(t *testing.T) {
+	j := jitteredSchedule{Period: time.Hour, Jitter: 0.1}
+	last := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	minNext := last.Add(54 * time.Minute)
+	maxNext := last.Add(66 * time.Minute)
+
+	for i := 0; i < 50; i++ {
+		got := j.NextRotation(last)
+		if got.Before(minNext) || got.After(maxNext) {
+			t.Fatalf("NextRotation %s outside expected jitter bounds [%s, %s]", got, minNext, maxNext)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package database
+
+import "time"
+
+// StaticAccount is a Vault-managed user associated with a static role, whose
+// password is rotated in place rather than issued as a new lease.
+type StaticAccount struct {
+	// Username is the database username that this account manages.
+	Username string `json:"username"`
+
+	// Password is the current password for this account, as last set by
+	// Vault.
+	Password string `json:"password"`
+
+	// LastVaultRotation is the last time Vault rotated this password.
+	LastVaultRotation time.Time `json:"last_vault_rotation"`
+
+	// RotationPeriod is the amount of time Vault should wait before rotating
+	// the password. The minimum rotation period is 5 seconds. It is ignored
+	// if RotationSchedule is set.
+	RotationPeriod time.Duration `json:"rotation_period"`
+
+	// RotationSchedule optionally overrides RotationPeriod with a cron
+	// expression or a jittered duration (e.g. "24h±10%"). See
+	// ParseRotationSchedule for the accepted forms.
+	RotationSchedule string `json:"rotation_schedule" mapstructure:"rotation_schedule"`
+
+	// AllowManualPassword permits operators to supply the exact password to
+	// rotate to via the static-roles/:name/rotate endpoint, instead of
+	// always letting the database plugin generate one.
+	AllowManualPassword bool `json:"allow_manual_password" mapstructure:"allow_manual_password"`
+
+	// PasswordPolicy constrains any operator-supplied password. It is
+	// ignored for Vault-generated passwords.
+	PasswordPolicy PasswordPolicy `json:"password_policy" mapstructure:"password_policy"`
+}
+
+// NextRotationTime returns the next time this account's password is due to
+// be rotated, consulting RotationSchedule if set and otherwise falling back
+// to the fixed RotationPeriod.
+func (s *StaticAccount) NextRotationTime() time.Time {
+	schedule, err := s.Schedule()
+	if err != nil {
+		schedule = periodicSchedule{Period: s.RotationPeriod}
+	}
+	return schedule.NextRotation(s.LastVaultRotation)
+}
+
+// Schedule returns the RotationSchedule implementation this account should
+// use to compute its next rotation time, parsing RotationSchedule if set or
+// falling back to a periodicSchedule built from RotationPeriod.
+func (s *StaticAccount) Schedule() (RotationSchedule, error) {
+	if s.RotationSchedule == "" {
+		return periodicSchedule{Period: s.RotationPeriod}, nil
+	}
+	return ParseRotationSchedule(s.RotationSchedule)
+}
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStaticAccountRepository_PutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryStaticAccountRepository()
+
+	role := &roleEntry{
+		Name:   "my-role",
+		DBName: "my-db",
+		StaticAccount: &StaticAccount{
+			Username:       "svc_app",
+			RotationPeriod: time.Hour,
+		},
+	}
+
+	if err := repo.Put(ctx, role); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+
+	got, err := repo.Get(ctx, "my-role")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got == nil {
+		t.Fatal("expected role, got nil")
+	}
+	if got.StaticAccount.Username != "svc_app" {
+		t.Fatalf("expected username svc_app, got %q", got.StaticAccount.Username)
+	}
+
+	// mutating the returned role must not affect the stored copy
+	got.StaticAccount.Username = "mutated"
+	reGot, err := repo.Get(ctx, "my-role")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if reGot.StaticAccount.Username != "svc_app" {
+		t.Fatalf("repository did not isolate its copy: got %q", reGot.StaticAccount.Username)
+	}
+
+	if err := repo.Delete(ctx, "my-role"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	got, err = repo.Get(ctx, "my-role")
+	if err != nil {
+		t.Fatalf("Get after delete returned error: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil after delete, got %+v", got)
+	}
+}
+
+func TestMemoryStaticAccountRepository_GetMissing(t *testing.T) {
+	repo := NewMemoryStaticAccountRepository()
+
+	got, err := repo.Get(context.Background(), "does-not-exist")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil for missing role, got %+v", got)
+	}
+}
+
+func TestMemoryStaticAccountRepository_GetNotStaticRole(t *testing.T) {
+	repo := NewMemoryStaticAccountRepository()
+
+	_, err := repo.Get(context.Background(), "dynamic-role")
+	if err != nil {
+		t.Fatalf("expected no error putting nothing, got %v", err)
+	}
+
+	// This case bypasses Put, since Put requires a StaticAccount;
+	// simulate a non-static role entry landing in storage
+	// the way the storage-backed implementation would see it.
+	mem, ok := repo.(*memoryStaticAccountRepository)
+	if !ok {
+		t.Fatal("expected memoryStaticAccountRepository")
+	}
+	mem.roles["dynamic-role"] = &roleEntry{Name: "dynamic-role"}
+
+	_, err = repo.Get(context.Background(), "dynamic-role")
+	if !errors.Is(err, ErrNotStaticRole) {
+		t.Fatalf("expected ErrNotStaticRole, got %v", err)
+	}
+}
+
+func TestMemoryStaticAccountRepository_PutRejectsNonStatic(t *testing.T) {
+	repo := NewMemoryStaticAccountRepository()
+
+	err := repo.Put(context.Background(), &roleEntry{Name: "no-static-account"})
+	if err == nil {
+		t.Fatal("expected error putting a role with no StaticAccount")
+	}
+}
@@ -0,0 +1,27 @@
+package database
+
+import (
+	"time"
+)
+
+const (
+	walRotationKey = "staticRotationKey"
+)
+
+// walSetCredentials is the WAL entry written before a static account's
+// credentials are changed in the remote database. It lets Vault recover the
+// in-flight password if the process crashes between setting the new
+// credentials and persisting the updated role entry.
+type walSetCredentials struct {
+	RoleName          string
+	Username          string
+	NewPassword       string
+	OldPassword       string
+	Statements        []string
+	LastVaultRotation time.Time
+
+	// OperatorSupplied records whether NewPassword was provided by an
+	// operator via the rotate API, as opposed to generated by the database
+	// plugin, so audit logs can distinguish the two.
+	OperatorSupplied bool
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookNotifier is a RotationNotifier that POSTs the event as JSON to a
+// configured URL, retrying with exponential backoff on failure.
+type WebhookNotifier struct {
+	URL        string
+	Client     *http.Client
+	MaxRetries int
+	BackoffMin time.Duration
+	BackoffMax time.Duration
+}
+
+// NewWebhookNotifier returns a WebhookNotifier posting to url with sane
+// retry defaults.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		URL:        url,
+		Client:     &http.Client{Timeout: 10 * time.Second},
+		MaxRetries: 3,
+		BackoffMin: 500 * time.Millisecond,
+		BackoffMax: 5 * time.Second,
+	}
+}
+
+// Notify implements RotationNotifier.
+func (w *WebhookNotifier) Notify(ctx context.Context, event RotationEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	backoff := w.BackoffMin
+	for attempt := 0; attempt <= w.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > w.BackoffMax {
+				backoff = w.BackoffMax
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := w.Client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return fmt.Errorf("rotation webhook failed after %d attempts: %w", w.MaxRetries+1, lastErr)
+}
@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifier_Notify_SucceedsFirstTry(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	n := NewWebhookNotifier(srv.URL)
+	n.BackoffMin = time.Millisecond
+	n.BackoffMax = time.Millisecond
+
+	if err := n.Notify(context.Background(), RotationEvent{Role: "my-role"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected 1 call, got %d", got)
+	}
+}
+
+func TestWebhookNotifier_Notify_RetriesThenSucceeds(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	n := NewWebhookNotifier(srv.URL)
+	n.MaxRetries = 3
+	n.BackoffMin = time.Millisecond
+	n.BackoffMax = time.Millisecond
+
+	if err := n.Notify(context.Background(), RotationEvent{Role: "my-role"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Fatalf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestWebhookNotifier_Notify_FailsAfterExhaustingRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	n := NewWebhookNotifier(srv.URL)
+	n.MaxRetries = 2
+	n.BackoffMin = time.Millisecond
+	n.BackoffMax = time.Millisecond
+
+	err := n.Notify(context.Background(), RotationEvent{Role: "my-role"})
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries")
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Fatalf("expected 3 calls (1 + 2 retries), got %d", got)
+	}
+}
+
+func TestWebhookNotifier_Notify_AbortsOnContextCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	n := NewWebhookNotifier(srv.URL)
+	n.MaxRetries = 5
+	n.BackoffMin = time.Hour
+	n.BackoffMax = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	err := n.Notify(ctx, RotationEvent{Role: "my-role"})
+	if err == nil {
+		t.Fatal("expected an error when the context is canceled mid-backoff")
+	}
+}
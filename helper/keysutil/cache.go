@@ -8,6 +8,16 @@ const (
 	LRU
 )
 
+// CacheStats reports point-in-time counters for a Cache implementation.
+// Implementations that don't track a particular counter (e.g. a no-op
+// cache) may leave it zero.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
 type Cache interface {
 	CacheActive() bool
 	Type() CacheType
@@ -16,3 +26,11 @@ type Cache interface {
 	Store(key, value interface{})
 	Size() int
 }
+
+// StatsCache is implemented by Cache implementations that track hit/miss/
+// eviction counters. Callers that want stats should type-assert a Cache
+// against this interface rather than requiring every implementation to
+// carry it.
+type StatsCache interface {
+	Stats() CacheStats
+}
@@ -0,0 +1,168 @@
+package keysutil
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Zeroizer is implemented by cache values that hold cryptographic key
+// material and need it scrubbed from memory as soon as they're evicted.
+type Zeroizer interface {
+	Zeroize()
+}
+
+type lruEntry struct {
+	key       interface{}
+	value     interface{}
+	expiresAt time.Time
+}
+
+// LRUCache is a bounded, size-evicting Cache implementation with an
+// optional per-entry TTL. Since this cache holds decrypted encryption keys,
+// any evicted value implementing Zeroizer is zeroized before being dropped.
+type LRUCache struct {
+	size int
+	ttl  time.Duration
+
+	mu    sync.Mutex
+	items map[interface{}]*list.Element
+	order *list.List
+
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+// NewLRUCache returns an LRUCache holding at most size entries. If ttl is
+// non-zero, entries are also considered expired (and evicted on next
+// access) once they've lived in the cache that long.
+func NewLRUCache(size int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		size:  size,
+		ttl:   ttl,
+		items: make(map[interface{}]*list.Element),
+		order: list.New(),
+	}
+}
+
+// CacheActive implements Cache.
+func (c *LRUCache) CacheActive() bool {
+	return c.size > 0
+}
+
+// Type implements Cache.
+func (c *LRUCache) Type() CacheType {
+	return LRU
+}
+
+// Load implements Cache.
+func (c *LRUCache) Load(key interface{}) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if c.expired(entry) {
+		c.removeElement(elem)
+		atomic.AddUint64(&c.evictions, 1)
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	atomic.AddUint64(&c.hits, 1)
+	return entry.value, true
+}
+
+// Store implements Cache.
+func (c *LRUCache) Store(key, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = time.Now().Add(c.ttl)
+	}
+
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		if z, ok := entry.value.(Zeroizer); ok {
+			z.Zeroize()
+		}
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	elem := c.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = elem
+
+	for c.size > 0 && len(c.items) > c.size {
+		c.evictOldest()
+	}
+}
+
+// Delete implements Cache.
+func (c *LRUCache) Delete(key interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// Size implements Cache.
+func (c *LRUCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Stats implements StatsCache. It is not part of the Cache interface.
+func (c *LRUCache) Stats() CacheStats {
+	c.mu.Lock()
+	size := len(c.items)
+	c.mu.Unlock()
+
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Evictions: atomic.LoadUint64(&c.evictions),
+		Size:      size,
+	}
+}
+
+func (c *LRUCache) expired(entry *lruEntry) bool {
+	return c.ttl > 0 && time.Now().After(entry.expiresAt)
+}
+
+// evictOldest removes the least recently used entry. Callers must hold c.mu.
+func (c *LRUCache) evictOldest() {
+	elem := c.order.Back()
+	if elem == nil {
+		return
+	}
+	c.removeElement(elem)
+	atomic.AddUint64(&c.evictions, 1)
+}
+
+// removeElement deletes elem from the cache, zeroizing its value if it
+// implements Zeroizer. Callers must hold c.mu.
+func (c *LRUCache) removeElement(elem *list.Element) {
+	entry := elem.Value.(*lruEntry)
+	c.order.Remove(elem)
+	delete(c.items, entry.key)
+
+	if z, ok := entry.value.(Zeroizer); ok {
+		z.Zeroize()
+	}
+}
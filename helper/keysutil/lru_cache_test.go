@@ -0,0 +1,144 @@
+package keysutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCache_StoreLoad(t *testing.T) {
+	c := NewLRUCache(2, 0)
+
+	c.Store("a", 1)
+	c.Store("b", 2)
+
+	if v, ok := c.Load("a"); !ok || v != 1 {
+		t.Fatalf("expected (1, true), got (%v, %v)", v, ok)
+	}
+	if v, ok := c.Load("b"); !ok || v != 2 {
+		t.Fatalf("expected (2, true), got (%v, %v)", v, ok)
+	}
+	if _, ok := c.Load("missing"); ok {
+		t.Fatal("expected miss for absent key")
+	}
+}
+
+func TestLRUCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRUCache(2, 0)
+
+	c.Store("a", 1)
+	c.Store("b", 2)
+
+	// touch "a" so "b" becomes the least recently used entry
+	c.Load("a")
+
+	c.Store("c", 3)
+
+	if _, ok := c.Load("b"); ok {
+		t.Fatal("expected b to have been evicted")
+	}
+	if _, ok := c.Load("a"); !ok {
+		t.Fatal("expected a to still be cached")
+	}
+	if _, ok := c.Load("c"); !ok {
+		t.Fatal("expected c to be cached")
+	}
+
+	stats := c.Stats()
+	if stats.Evictions != 1 {
+		t.Fatalf("expected 1 eviction, got %d", stats.Evictions)
+	}
+	if stats.Size != 2 {
+		t.Fatalf("expected size 2, got %d", stats.Size)
+	}
+}
+
+func TestLRUCache_TTLExpiry(t *testing.T) {
+	c := NewLRUCache(2, 10*time.Millisecond)
+
+	c.Store("a", 1)
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Load("a"); ok {
+		t.Fatal("expected entry to have expired")
+	}
+
+	stats := c.Stats()
+	if stats.Evictions != 1 {
+		t.Fatalf("expected expiry to count as an eviction, got %d", stats.Evictions)
+	}
+}
+
+type zeroizeRecorder struct {
+	zeroized bool
+}
+
+func (z *zeroizeRecorder) Zeroize() {
+	z.zeroized = true
+}
+
+func TestLRUCache_ZeroizesOnEviction(t *testing.T) {
+	c := NewLRUCache(1, 0)
+
+	first := &zeroizeRecorder{}
+	second := &zeroizeRecorder{}
+
+	c.Store("a", first)
+	c.Store("b", second)
+
+	if !first.zeroized {
+		t.Fatal("expected evicted value to be zeroized")
+	}
+	if second.zeroized {
+		t.Fatal("did not expect the still-cached value to be zeroized")
+	}
+
+	c.Delete("b")
+	if !second.zeroized {
+		t.Fatal("expected explicitly deleted value to be zeroized")
+	}
+}
+
+func TestLRUCache_ZeroizesOnOverwrite(t *testing.T) {
+	c := NewLRUCache(2, 0)
+
+	old := &zeroizeRecorder{}
+	replacement := &zeroizeRecorder{}
+
+	c.Store("a", old)
+	c.Store("a", replacement)
+
+	if !old.zeroized {
+		t.Fatal("expected value replaced by a re-Store under the same key to be zeroized")
+	}
+	if replacement.zeroized {
+		t.Fatal("did not expect the new value to be zeroized")
+	}
+
+	v, ok := c.Load("a")
+	if !ok || v != replacement {
+		t.Fatalf("expected replacement value to be cached, got (%v, %v)", v, ok)
+	}
+}
+
+func TestLRUCache_StatsCounts(t *testing.T) {
+	c := NewLRUCache(10, 0)
+
+	var cache Cache = c
+	statsCache, ok := cache.(StatsCache)
+	if !ok {
+		t.Fatal("expected LRUCache to implement StatsCache")
+	}
+
+	c.Store("a", 1)
+	c.Load("a")
+	c.Load("missing")
+
+	stats := statsCache.Stats()
+	if stats.Hits != 1 {
+		t.Fatalf("expected 1 hit, got %d", stats.Hits)
+	}
+	if stats.Misses != 1 {
+		t.Fatalf("expected 1 miss, got %d", stats.Misses)
+	}
+}